@@ -0,0 +1,169 @@
+package strellerminds
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// streamPollInterval is how often StreamProgress re-fetches a student's
+// sessions while waiting for new activity.
+const streamPollInterval = 5 * time.Second
+
+// ScoringStrategy turns a student's sessions into a score, letting
+// instructors plug in course-specific rubrics.
+type ScoringStrategy interface {
+	Score(sessions []LearningSession) uint32
+}
+
+// LinearScoring awards a fixed number of points per completed session.
+type LinearScoring struct {
+	PointsPerSession uint32
+}
+
+// Score implements ScoringStrategy.
+func (s LinearScoring) Score(sessions []LearningSession) uint32 {
+	return s.PointsPerSession * uint32(len(sessions))
+}
+
+// WeightedByModuleScoring awards points per session, weighted by the
+// course the session belongs to. Courses absent from Weights fall back to
+// DefaultWeight.
+type WeightedByModuleScoring struct {
+	Weights       map[string]uint32
+	DefaultWeight uint32
+}
+
+// Score implements ScoringStrategy.
+func (s WeightedByModuleScoring) Score(sessions []LearningSession) uint32 {
+	var total uint32
+	for _, session := range sessions {
+		if w, ok := s.Weights[session.CourseID]; ok {
+			total += w
+		} else {
+			total += s.DefaultWeight
+		}
+	}
+	return total
+}
+
+// DecayOverTimeScoring awards full points for recent sessions and decays
+// the contribution of older ones by half every HalfLife.
+type DecayOverTimeScoring struct {
+	PointsPerSession uint32
+	HalfLife         time.Duration
+
+	// Now returns the current time; defaults to time.Now when nil. Tests
+	// can override it for deterministic decay calculations.
+	Now func() time.Time
+}
+
+// Score implements ScoringStrategy.
+func (s DecayOverTimeScoring) Score(sessions []LearningSession) uint32 {
+	now := s.Now
+	if now == nil {
+		now = time.Now
+	}
+	nowUnix := uint64(now().Unix())
+
+	var total float64
+	for _, session := range sessions {
+		if s.HalfLife <= 0 || session.StartTime >= nowUnix {
+			total += float64(s.PointsPerSession)
+			continue
+		}
+		age := time.Duration(nowUnix-session.StartTime) * time.Second
+		decay := math.Pow(0.5, float64(age)/float64(s.HalfLife))
+		total += float64(s.PointsPerSession) * decay
+	}
+	return uint32(total)
+}
+
+// ProgressAggregator computes ProgressAnalytics for a student, either from
+// sessions already in hand or fetched live from the contract.
+type ProgressAggregator struct {
+	Client   *AnalyticsClient
+	Strategy ScoringStrategy
+}
+
+// NewProgressAggregator creates a ProgressAggregator backed by client. If
+// strategy is nil, it defaults to LinearScoring with one point per
+// session.
+func NewProgressAggregator(client *AnalyticsClient, strategy ScoringStrategy) *ProgressAggregator {
+	if strategy == nil {
+		strategy = LinearScoring{PointsPerSession: 1}
+	}
+	return &ProgressAggregator{Client: client, Strategy: strategy}
+}
+
+// Compute aggregates sessions already in hand into a ProgressAnalytics.
+//
+// TODO: TotalTime is left at zero. LearningSession only records a session's
+// start time, not its duration or end time, so there is no elapsed time to
+// sum here; summing raw StartTime values would produce a meaningless
+// Unix-timestamp total. Fill this in once LearningSession (and the
+// record_session contract args in encodeSessionArgs) carry a duration.
+func (a *ProgressAggregator) Compute(student string, sessions []LearningSession) ProgressAnalytics {
+	modules := make(map[string]struct{})
+	for _, session := range sessions {
+		modules[session.CourseID] = struct{}{}
+	}
+	return ProgressAnalytics{
+		CompletedModules: uint32(len(modules)),
+		Score:            a.Strategy.Score(sessions),
+	}
+}
+
+// FetchAndCompute pulls student's sessions from the contract and computes
+// their current ProgressAnalytics.
+func (a *ProgressAggregator) FetchAndCompute(ctx context.Context, student string) (ProgressAnalytics, error) {
+	sessions, err := a.Client.ListSessionsByStudentContext(ctx, student)
+	if err != nil {
+		return ProgressAnalytics{}, err
+	}
+	return a.Compute(student, sessions), nil
+}
+
+// StreamProgress emits student's ProgressAnalytics on the returned channel
+// immediately, then re-fetches every streamPollInterval and emits again
+// whenever the recomputed ProgressAnalytics differs from the last one
+// emitted, until ctx is cancelled. The channel is closed when StreamProgress stops, whether
+// because ctx ended or because a fetch failed.
+func (a *ProgressAggregator) StreamProgress(ctx context.Context, student string) (<-chan ProgressAnalytics, error) {
+	initial, err := a.FetchAndCompute(ctx, student)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan ProgressAnalytics, 1)
+	out <- initial
+
+	go func() {
+		defer close(out)
+		ticker := time.NewTicker(streamPollInterval)
+		defer ticker.Stop()
+		last := initial
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				progress, err := a.FetchAndCompute(ctx, student)
+				if err != nil {
+					return
+				}
+				if progress != last {
+					last = progress
+					select {
+					case out <- progress:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
@@ -0,0 +1,95 @@
+package strellerminds
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLinearScoring(t *testing.T) {
+	sessions := []LearningSession{{ID: "a"}, {ID: "b"}, {ID: "c"}}
+	got := LinearScoring{PointsPerSession: 5}.Score(sessions)
+	if want := uint32(15); got != want {
+		t.Errorf("Score() = %d, want %d", got, want)
+	}
+	if got := (LinearScoring{PointsPerSession: 5}).Score(nil); got != 0 {
+		t.Errorf("Score(nil) = %d, want 0", got)
+	}
+}
+
+func TestWeightedByModuleScoring(t *testing.T) {
+	strategy := WeightedByModuleScoring{
+		Weights:       map[string]uint32{"course-a": 10, "course-b": 20},
+		DefaultWeight: 1,
+	}
+	sessions := []LearningSession{
+		{CourseID: "course-a"},
+		{CourseID: "course-b"},
+		{CourseID: "course-a"},
+		{CourseID: "unknown-course"},
+	}
+	got := strategy.Score(sessions)
+	if want := uint32(10 + 20 + 10 + 1); got != want {
+		t.Errorf("Score() = %d, want %d", got, want)
+	}
+}
+
+func TestDecayOverTimeScoring(t *testing.T) {
+	now := time.Unix(1_000_000, 0)
+	strategy := DecayOverTimeScoring{
+		PointsPerSession: 100,
+		HalfLife:         time.Hour,
+		Now:              func() time.Time { return now },
+	}
+
+	sessions := []LearningSession{
+		{StartTime: uint64(now.Unix())},                     // no age: full points
+		{StartTime: uint64(now.Add(-1 * time.Hour).Unix())}, // one half-life: ~50 points
+		{StartTime: uint64(now.Add(-2 * time.Hour).Unix())}, // two half-lives: ~25 points
+	}
+
+	got := strategy.Score(sessions[:1])
+	if got != 100 {
+		t.Errorf("fresh session score = %d, want 100", got)
+	}
+
+	got = strategy.Score(sessions[1:2])
+	if got < 49 || got > 51 {
+		t.Errorf("one half-life score = %d, want ~50", got)
+	}
+
+	got = strategy.Score(sessions[2:3])
+	if got < 24 || got > 26 {
+		t.Errorf("two half-lives score = %d, want ~25", got)
+	}
+}
+
+func TestDecayOverTimeScoringZeroHalfLife(t *testing.T) {
+	strategy := DecayOverTimeScoring{PointsPerSession: 10, HalfLife: 0}
+	sessions := []LearningSession{{StartTime: 1}, {StartTime: 2}}
+	if got, want := strategy.Score(sessions), uint32(20); got != want {
+		t.Errorf("Score() = %d, want %d (no decay when HalfLife is zero)", got, want)
+	}
+}
+
+func TestProgressAggregatorCompute(t *testing.T) {
+	agg := NewProgressAggregator(nil, LinearScoring{PointsPerSession: 2})
+	sessions := []LearningSession{
+		{CourseID: "course-a", StartTime: 100},
+		{CourseID: "course-a", StartTime: 200},
+		{CourseID: "course-b", StartTime: 300},
+	}
+
+	got := agg.Compute("student-1", sessions)
+	want := ProgressAnalytics{CompletedModules: 2, TotalTime: 0, Score: 6}
+	if got != want {
+		t.Errorf("Compute() = %+v, want %+v", got, want)
+	}
+}
+
+func TestNewProgressAggregatorDefaultsToLinearScoring(t *testing.T) {
+	agg := NewProgressAggregator(nil, nil)
+	sessions := []LearningSession{{ID: "a"}, {ID: "b"}}
+	if got, want := agg.Compute("student-1", sessions).Score, uint32(2); got != want {
+		t.Errorf("default strategy score = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,94 @@
+package strellerminds
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// BatchOptions configures RecordSessions and RecordSessionsContext.
+type BatchOptions struct {
+	// Concurrency is the number of sessions submitted in parallel. Zero or
+	// negative defaults to 4.
+	Concurrency int
+	// StopOnError stops dispatching sessions as soon as one fails; sessions
+	// not yet started get context.Canceled, while submits already in flight
+	// run to completion.
+	StopOnError bool
+	// Timeout bounds the whole batch; zero means no timeout.
+	Timeout time.Duration
+}
+
+func (o BatchOptions) concurrency() int {
+	if o.Concurrency <= 0 {
+		return 4
+	}
+	return o.Concurrency
+}
+
+// RecordSessions submits many sessions concurrently with a bounded worker
+// pool, returning one BatchResult per input session in the same order, so
+// bulk imports of learning data from an LMS don't need to reinvent
+// goroutine orchestration.
+func (c *AnalyticsClient) RecordSessions(sessions []LearningSession, sourceSecret string, opts BatchOptions) ([]BatchResult, error) {
+	ctx := context.Background()
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+	return c.RecordSessionsContext(ctx, sessions, sourceSecret, opts)
+}
+
+// RecordSessionsContext is the context-aware variant of RecordSessions. It
+// stops dispatching new work once ctx is done or, if opts.StopOnError is
+// set, as soon as one session fails.
+func (c *AnalyticsClient) RecordSessionsContext(ctx context.Context, sessions []LearningSession, sourceSecret string, opts BatchOptions) ([]BatchResult, error) {
+	return runBatch(ctx, sessions, opts, func(ctx context.Context, session LearningSession) (string, error) {
+		return c.RecordSessionContext(ctx, session, sourceSecret)
+	})
+}
+
+// runBatch runs submit concurrently over sessions with a bounded worker
+// pool, returning one BatchResult per input session in the same order.
+// The orchestration (concurrency bound, StopOnError, timeout/cancellation
+// propagation) is factored out of RecordSessionsContext into this
+// standalone function so it can be unit-tested against a fake submit
+// function with no network dependency.
+func runBatch(ctx context.Context, sessions []LearningSession, opts BatchOptions, submit func(context.Context, LearningSession) (string, error)) ([]BatchResult, error) {
+	results := make([]BatchResult, len(sessions))
+	sem := make(chan struct{}, opts.concurrency())
+	stopped := make(chan struct{})
+	var stopOnce sync.Once
+	var wg sync.WaitGroup
+
+	for i, session := range sessions {
+		sem <- struct{}{}
+		select {
+		case <-ctx.Done():
+			<-sem
+			results[i] = BatchResult{Session: session, Err: ctx.Err()}
+			continue
+		case <-stopped:
+			<-sem
+			results[i] = BatchResult{Session: session, Err: context.Canceled}
+			continue
+		default:
+		}
+
+		wg.Add(1)
+		go func(i int, session LearningSession) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			hash, err := submit(ctx, session)
+			results[i] = BatchResult{Session: session, TxHash: hash, Err: err}
+			if err != nil && opts.StopOnError {
+				stopOnce.Do(func() { close(stopped) })
+			}
+		}(i, session)
+	}
+	wg.Wait()
+
+	return results, ctx.Err()
+}
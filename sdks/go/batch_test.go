@@ -0,0 +1,137 @@
+package strellerminds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func sessionsN(n int) []LearningSession {
+	sessions := make([]LearningSession, n)
+	for i := range sessions {
+		sessions[i] = LearningSession{ID: fmt.Sprintf("session-%d", i)}
+	}
+	return sessions
+}
+
+func TestRunBatchRespectsConcurrencyLimit(t *testing.T) {
+	const concurrency = 3
+	var inFlight, maxInFlight int32
+
+	submit := func(ctx context.Context, session LearningSession) (string, error) {
+		cur := atomic.AddInt32(&inFlight, 1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if cur <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, cur) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		return session.ID, nil
+	}
+
+	results, err := runBatch(context.Background(), sessionsN(10), BatchOptions{Concurrency: concurrency}, submit)
+	if err != nil {
+		t.Fatalf("runBatch: %v", err)
+	}
+	for i, r := range results {
+		if r.Err != nil {
+			t.Errorf("result %d: unexpected error %v", i, r.Err)
+		}
+	}
+	if got := atomic.LoadInt32(&maxInFlight); got > concurrency {
+		t.Errorf("observed %d concurrent submits, want at most %d", got, concurrency)
+	}
+}
+
+func TestRunBatchDefaultConcurrency(t *testing.T) {
+	var inFlight, maxInFlight int32
+	submit := func(ctx context.Context, session LearningSession) (string, error) {
+		cur := atomic.AddInt32(&inFlight, 1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if cur <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, cur) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		return session.ID, nil
+	}
+
+	if _, err := runBatch(context.Background(), sessionsN(8), BatchOptions{}, submit); err != nil {
+		t.Fatalf("runBatch: %v", err)
+	}
+	if got := atomic.LoadInt32(&maxInFlight); got > 4 {
+		t.Errorf("observed %d concurrent submits with default options, want at most 4", got)
+	}
+}
+
+func TestRunBatchStopOnError(t *testing.T) {
+	wantErr := errors.New("boom")
+	var calls int32
+
+	submit := func(ctx context.Context, session LearningSession) (string, error) {
+		n := atomic.AddInt32(&calls, 1)
+		if n == 1 {
+			return "", wantErr
+		}
+		return session.ID, nil
+	}
+
+	sessions := sessionsN(5)
+	results, _ := runBatch(context.Background(), sessions, BatchOptions{Concurrency: 1, StopOnError: true}, submit)
+
+	if results[0].Err != wantErr {
+		t.Errorf("result 0: got err %v, want %v", results[0].Err, wantErr)
+	}
+	if results[len(results)-1].Err != context.Canceled {
+		t.Errorf("last result: got err %v, want context.Canceled once stopped", results[len(results)-1].Err)
+	}
+	if got := atomic.LoadInt32(&calls); got >= int32(len(sessions)) {
+		t.Errorf("submit was called %d times, want it to stop well before all %d sessions", got, len(sessions))
+	}
+}
+
+func TestRunBatchContinuesWithoutStopOnError(t *testing.T) {
+	wantErr := errors.New("boom")
+	submit := func(ctx context.Context, session LearningSession) (string, error) {
+		if session.ID == "session-0" {
+			return "", wantErr
+		}
+		return session.ID, nil
+	}
+
+	sessions := sessionsN(4)
+	results, err := runBatch(context.Background(), sessions, BatchOptions{Concurrency: 1}, submit)
+	if err != nil {
+		t.Fatalf("runBatch: %v", err)
+	}
+	if results[0].Err != wantErr {
+		t.Errorf("result 0: got err %v, want %v", results[0].Err, wantErr)
+	}
+	for i := 1; i < len(results); i++ {
+		if results[i].Err != nil {
+			t.Errorf("result %d: got unexpected err %v", i, results[i].Err)
+		}
+	}
+}
+
+func TestRunBatchTimeout(t *testing.T) {
+	submit := func(ctx context.Context, session LearningSession) (string, error) {
+		time.Sleep(50 * time.Millisecond)
+		return session.ID, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+
+	_, err := runBatch(ctx, sessionsN(20), BatchOptions{Concurrency: 1}, submit)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got err %v, want context.DeadlineExceeded", err)
+	}
+}
@@ -1,36 +1,136 @@
-package strellerminds
-
-import (
-	"fmt"
-)
-
-// AnalyticsClient interacts with the StrellerMinds Analytics contract
-type AnalyticsClient struct {
-	ContractID        string
-	RPCURL            string
-	NetworkPassphrase string
-}
-
-// NewClient creates a new AnalyticsClient
-func NewClient(contractID, rpcURL, networkPassphrase string) *AnalyticsClient {
-	return &AnalyticsClient{
-		ContractID:        contractID,
-		RPCURL:            rpcURL,
-		NetworkPassphrase: networkPassphrase,
-	}
-}
-
-// RecordSession records a learning session
-func (c *AnalyticsClient) RecordSession(session LearningSession, sourceSecret string) (string, error) {
-	// Placeholder implementation
-	fmt.Printf("Recording session: %+v\n", session)
-	return "tx_hash_placeholder", nil
-}
-
-// GetSession retrieves a session by ID
-func (c *AnalyticsClient) GetSession(sessionID string) (*LearningSession, error) {
-	// Placeholder implementation
-	return &LearningSession{
-		ID: sessionID,
-	}, nil
-}
+package strellerminds
+
+import "context"
+
+// AnalyticsClient interacts with the StrellerMinds Analytics contract over
+// the Soroban RPC protocol.
+type AnalyticsClient struct {
+	ContractID        string
+	RPCURL            string
+	NetworkPassphrase string
+
+	deadlines  deadlines
+	lastCursor cursorStore
+	signer     Signer
+}
+
+// NewClient creates a new AnalyticsClient. By default RecordSession
+// derives a seed-based Signer from its sourceSecret parameter on each
+// call; pass WithSigner to sign with an HSM, remote signer, or keystore
+// instead.
+func NewClient(contractID, rpcURL, networkPassphrase string, opts ...ClientOption) *AnalyticsClient {
+	c := &AnalyticsClient{
+		ContractID:        contractID,
+		RPCURL:            rpcURL,
+		NetworkPassphrase: networkPassphrase,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// RecordSession signs a LearningSession with sourceSecret (a Stellar
+// ed25519 seed) and submits it to the analytics contract, polling until
+// the transaction confirms or fails. It is bounded by the client's write
+// deadline, if one was set with SetDeadline or SetWriteDeadline.
+func (c *AnalyticsClient) RecordSession(session LearningSession, sourceSecret string) (string, error) {
+	ctx, cancel := c.deadlines.context(c.deadlines.writeDeadline())
+	defer cancel()
+	return c.RecordSessionContext(ctx, session, sourceSecret)
+}
+
+// RecordSessionContext is the context-aware variant of RecordSession. If
+// the client was configured with WithSigner, sourceSecret is ignored and
+// that Signer signs the transaction instead.
+func (c *AnalyticsClient) RecordSessionContext(ctx context.Context, session LearningSession, sourceSecret string) (string, error) {
+	signer := c.signer
+	if signer == nil {
+		s, err := NewSeedSigner(sourceSecret)
+		if err != nil {
+			return "", err
+		}
+		signer = s
+	}
+	pub := signer.PublicKey()
+
+	sequence, err := c.fetchAccountSequenceContext(ctx, pub)
+	if err != nil {
+		return "", err
+	}
+
+	args := encodeSessionArgs(session)
+	envelope := c.buildInvokeHostFunctionEnvelope(pub, "record_session", args, sequence+1)
+
+	if _, err := c.simulateTransactionContext(ctx, envelope); err != nil {
+		return "", err
+	}
+
+	sig, err := signer.SignTransaction(ctx, c.NetworkPassphrase, envelope)
+	if err != nil {
+		return "", newError(ErrCodeSigning, "failed to sign transaction", err)
+	}
+	signed := append(append([]byte{}, envelope...), sig...)
+
+	var sendResult sendTransactionResult
+	if err := c.rpcCallContext(ctx, "sendTransaction", map[string]string{"transaction": base64XDR(signed)}, &sendResult); err != nil {
+		return "", err
+	}
+
+	return c.pollTransactionContext(ctx, sendResult.Hash)
+}
+
+// GetSession retrieves a session by ID from the analytics contract's
+// ledger entries. It is bounded by the client's read deadline, if one was
+// set with SetDeadline or SetReadDeadline.
+func (c *AnalyticsClient) GetSession(sessionID string) (*LearningSession, error) {
+	ctx, cancel := c.deadlines.context(c.deadlines.readDeadline())
+	defer cancel()
+	return c.GetSessionContext(ctx, sessionID)
+}
+
+// GetSessionContext is the context-aware variant of GetSession.
+func (c *AnalyticsClient) GetSessionContext(ctx context.Context, sessionID string) (*LearningSession, error) {
+	var raw struct {
+		ID        string `json:"id"`
+		Student   string `json:"student"`
+		StartTime uint64 `json:"start_time"`
+		CourseID  string `json:"course_id"`
+	}
+	if err := c.rpcCallContext(ctx, "getLedgerEntries", map[string]interface{}{
+		"contractId": c.ContractID,
+		"key":        base64XDR(scString(sessionID).encode()),
+	}, &raw); err != nil {
+		return nil, err
+	}
+	return &LearningSession{
+		ID:        raw.ID,
+		Student:   raw.Student,
+		StartTime: raw.StartTime,
+		CourseID:  raw.CourseID,
+	}, nil
+}
+
+// ListSessionsByStudent retrieves every session recorded on-chain for
+// student. It is bounded by the client's read deadline, if one was set
+// with SetDeadline or SetReadDeadline.
+func (c *AnalyticsClient) ListSessionsByStudent(student string) ([]LearningSession, error) {
+	ctx, cancel := c.deadlines.context(c.deadlines.readDeadline())
+	defer cancel()
+	return c.ListSessionsByStudentContext(ctx, student)
+}
+
+// ListSessionsByStudentContext is the context-aware variant of
+// ListSessionsByStudent.
+func (c *AnalyticsClient) ListSessionsByStudentContext(ctx context.Context, student string) ([]LearningSession, error) {
+	var raw struct {
+		Sessions []LearningSession `json:"sessions"`
+	}
+	if err := c.rpcCallContext(ctx, "getLedgerEntries", map[string]interface{}{
+		"contractId": c.ContractID,
+		"key":        base64XDR(scString(student).encode()),
+	}, &raw); err != nil {
+		return nil, err
+	}
+	return raw.Sessions, nil
+}
@@ -0,0 +1,202 @@
+package strellerminds
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func testSeed(t *testing.T) string {
+	t.Helper()
+	_, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	return encodeStrkey(strkeyVersionSeed, priv.Seed())
+}
+
+func requestMethod(t *testing.T, r *http.Request) string {
+	t.Helper()
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		t.Fatalf("read request body: %v", err)
+	}
+	var req jsonRPCRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		t.Fatalf("unmarshal request: %v", err)
+	}
+	return req.Method
+}
+
+func writeJSONRPCError(w http.ResponseWriter, code int, message string) {
+	body, _ := json.Marshal(jsonRPCError{Code: code, Message: message})
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":` + string(body) + `}`))
+}
+
+func TestRecordSessionContextSuccess(t *testing.T) {
+	seed := testSeed(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch requestMethod(t, r) {
+		case "getLedgerEntries":
+			writeJSONRPCResult(w, map[string]interface{}{
+				"entries": []map[string]string{{"sequence": "5"}},
+			})
+		case "simulateTransaction":
+			writeJSONRPCResult(w, getTransactionResult{Status: "SUCCESS"})
+		case "sendTransaction":
+			writeJSONRPCResult(w, sendTransactionResult{Hash: "tx-hash-1", Status: "PENDING"})
+		case "getTransaction":
+			writeJSONRPCResult(w, getTransactionResult{Status: "SUCCESS"})
+		default:
+			t.Errorf("unexpected RPC method %q", requestMethod(t, r))
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient("contract", server.URL, "Test Network")
+	hash, err := c.RecordSessionContext(context.Background(), LearningSession{ID: "s1"}, seed)
+	if err != nil {
+		t.Fatalf("RecordSessionContext: %v", err)
+	}
+	if hash != "tx-hash-1" {
+		t.Errorf("hash = %q, want %q", hash, "tx-hash-1")
+	}
+}
+
+func TestRecordSessionContextSimulationFailure(t *testing.T) {
+	seed := testSeed(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch requestMethod(t, r) {
+		case "getLedgerEntries":
+			writeJSONRPCResult(w, map[string]interface{}{
+				"entries": []map[string]string{{"sequence": "5"}},
+			})
+		case "simulateTransaction":
+			writeJSONRPCError(w, 1, "contract auth failed")
+		default:
+			t.Errorf("unexpected RPC method %q", requestMethod(t, r))
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient("contract", server.URL, "Test Network")
+	_, err := c.RecordSessionContext(context.Background(), LearningSession{ID: "s1"}, seed)
+	var clientErr Error
+	if !errors.As(err, &clientErr) || clientErr.Code() != ErrCodeSimulation {
+		t.Fatalf("RecordSessionContext err = %v, want an Error with code %q", err, ErrCodeSimulation)
+	}
+}
+
+func TestRecordSessionContextSigningFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("RPC should not be called when the source secret is invalid, got method %q", requestMethod(t, r))
+	}))
+	defer server.Close()
+
+	c := NewClient("contract", server.URL, "Test Network")
+	_, err := c.RecordSessionContext(context.Background(), LearningSession{ID: "s1"}, "not-a-seed")
+	var clientErr Error
+	if !errors.As(err, &clientErr) || clientErr.Code() != ErrCodeSigning {
+		t.Fatalf("RecordSessionContext err = %v, want an Error with code %q", err, ErrCodeSigning)
+	}
+}
+
+func TestRecordSessionContextAccountLookupError(t *testing.T) {
+	seed := testSeed(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSONRPCError(w, 1, "account not found")
+	}))
+	defer server.Close()
+
+	c := NewClient("contract", server.URL, "Test Network")
+	_, err := c.RecordSessionContext(context.Background(), LearningSession{ID: "s1"}, seed)
+	var clientErr Error
+	if !errors.As(err, &clientErr) || clientErr.Code() != ErrCodeContract {
+		t.Fatalf("RecordSessionContext err = %v, want an Error with code %q", err, ErrCodeContract)
+	}
+}
+
+func TestRecordSessionContextDeadlineExceeded(t *testing.T) {
+	seed := testSeed(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		writeJSONRPCResult(w, map[string]interface{}{
+			"entries": []map[string]string{{"sequence": "5"}},
+		})
+	}))
+	defer server.Close()
+
+	c := NewClient("contract", server.URL, "Test Network")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	_, err := c.RecordSessionContext(ctx, LearningSession{ID: "s1"}, seed)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("RecordSessionContext err = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestGetSessionContextSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSONRPCResult(w, map[string]interface{}{
+			"id": "s1", "student": "stu-1", "start_time": 100, "course_id": "course-a",
+		})
+	}))
+	defer server.Close()
+
+	c := NewClient("contract", server.URL, "Test Network")
+	got, err := c.GetSessionContext(context.Background(), "s1")
+	if err != nil {
+		t.Fatalf("GetSessionContext: %v", err)
+	}
+	want := &LearningSession{ID: "s1", Student: "stu-1", StartTime: 100, CourseID: "course-a"}
+	if *got != *want {
+		t.Errorf("GetSessionContext() = %+v, want %+v", got, want)
+	}
+}
+
+func TestGetSessionContextRPCError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSONRPCError(w, 1, "entry not found")
+	}))
+	defer server.Close()
+
+	c := NewClient("contract", server.URL, "Test Network")
+	_, err := c.GetSessionContext(context.Background(), "missing")
+	var clientErr Error
+	if !errors.As(err, &clientErr) || clientErr.Code() != ErrCodeContract {
+		t.Fatalf("GetSessionContext err = %v, want an Error with code %q", err, ErrCodeContract)
+	}
+}
+
+func TestListSessionsByStudentContextSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSONRPCResult(w, map[string]interface{}{
+			"sessions": []map[string]interface{}{
+				{"id": "s1", "student": "stu-1", "start_time": 100, "course_id": "course-a"},
+				{"id": "s2", "student": "stu-1", "start_time": 200, "course_id": "course-b"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	c := NewClient("contract", server.URL, "Test Network")
+	got, err := c.ListSessionsByStudentContext(context.Background(), "stu-1")
+	if err != nil {
+		t.Fatalf("ListSessionsByStudentContext: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("ListSessionsByStudentContext returned %d sessions, want 2", len(got))
+	}
+}
@@ -0,0 +1,72 @@
+package strellerminds
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// deadlines holds the read/write deadlines set via SetDeadline,
+// SetReadDeadline, and SetWriteDeadline, following the same pattern as
+// netstack's gonet adapter: a deadline bounds the context used by calls
+// that were not given one explicitly.
+type deadlines struct {
+	mu    sync.Mutex
+	read  time.Time
+	write time.Time
+}
+
+func (d *deadlines) setRead(t time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.read = t
+}
+
+func (d *deadlines) setWrite(t time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.write = t
+}
+
+func (d *deadlines) readDeadline() time.Time {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.read
+}
+
+func (d *deadlines) writeDeadline() time.Time {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.write
+}
+
+// context returns a context bounded by t, falling back to
+// context.Background() when t is the zero value.
+func (d *deadlines) context(t time.Time) (context.Context, context.CancelFunc) {
+	if t.IsZero() {
+		return context.Background(), func() {}
+	}
+	return context.WithDeadline(context.Background(), t)
+}
+
+// SetDeadline sets both the read and write deadlines for subsequent calls
+// that do not take an explicit context.Context.
+func (c *AnalyticsClient) SetDeadline(t time.Time) error {
+	c.deadlines.setRead(t)
+	c.deadlines.setWrite(t)
+	return nil
+}
+
+// SetReadDeadline sets the deadline for read-only calls such as
+// GetSession.
+func (c *AnalyticsClient) SetReadDeadline(t time.Time) error {
+	c.deadlines.setRead(t)
+	return nil
+}
+
+// SetWriteDeadline sets the deadline for calls that submit transactions,
+// such as RecordSession.
+func (c *AnalyticsClient) SetWriteDeadline(t time.Time) error {
+	c.deadlines.setWrite(t)
+	return nil
+}
@@ -0,0 +1,60 @@
+package strellerminds
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func slowServer(t *testing.T, delay time.Duration) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(delay)
+		writeJSONRPCResult(w, map[string]interface{}{
+			"id": "s1", "student": "stu-1", "start_time": 100, "course_id": "course-a",
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSetReadDeadlineBoundsSlowCall(t *testing.T) {
+	server := slowServer(t, 50*time.Millisecond)
+
+	c := NewClient("contract", server.URL, "Test Network")
+	if err := c.SetReadDeadline(time.Now().Add(time.Millisecond)); err != nil {
+		t.Fatalf("SetReadDeadline: %v", err)
+	}
+
+	_, err := c.GetSession("s1")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("GetSession err = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestSetDeadlineBoundsWriteCall(t *testing.T) {
+	server := slowServer(t, 50*time.Millisecond)
+	seed := testSeed(t)
+
+	c := NewClient("contract", server.URL, "Test Network")
+	if err := c.SetDeadline(time.Now().Add(time.Millisecond)); err != nil {
+		t.Fatalf("SetDeadline: %v", err)
+	}
+
+	_, err := c.RecordSession(LearningSession{ID: "s1"}, seed)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("RecordSession err = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestDeadlinesContextFallsBackToBackgroundWhenUnset(t *testing.T) {
+	var d deadlines
+	ctx, cancel := d.context(d.readDeadline())
+	defer cancel()
+	if _, ok := ctx.Deadline(); ok {
+		t.Error("context() should return a deadline-free context when no deadline was set")
+	}
+}
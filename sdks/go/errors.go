@@ -0,0 +1,48 @@
+package strellerminds
+
+import "fmt"
+
+// Error codes returned by AnalyticsClient methods. Callers should branch on
+// Code() rather than parsing Error() strings.
+const (
+	ErrCodeNetwork           = "NetworkError"
+	ErrCodeSigning           = "SigningError"
+	ErrCodeSimulation        = "SimulationError"
+	ErrCodeContract          = "ContractError"
+	ErrCodeTransactionFailed = "TransactionFailedError"
+	ErrCodeTimeout           = "TimeoutError"
+)
+
+// Error is the typed error returned by AnalyticsClient methods, modeled
+// after the AWS SDK's awserr.Error so callers can distinguish network
+// errors, contract errors, simulation failures, and signing errors without
+// string-matching Error().
+type Error interface {
+	error
+	Code() string
+	Message() string
+	OrigErr() error
+}
+
+type clientError struct {
+	code    string
+	message string
+	orig    error
+}
+
+func newError(code, message string, orig error) *clientError {
+	return &clientError{code: code, message: message, orig: orig}
+}
+
+func (e *clientError) Code() string    { return e.code }
+func (e *clientError) Message() string { return e.message }
+func (e *clientError) OrigErr() error  { return e.orig }
+
+func (e *clientError) Error() string {
+	if e.orig != nil {
+		return fmt.Sprintf("%s: %s: %s", e.code, e.message, e.orig)
+	}
+	return fmt.Sprintf("%s: %s", e.code, e.message)
+}
+
+func (e *clientError) Unwrap() error { return e.orig }
@@ -0,0 +1,137 @@
+package strellerminds
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// eventPollInterval is how often SubscribeEvents calls getEvents while
+// waiting for new activity, and eventMaxBackoff caps how far repeated
+// getEvents failures push that interval out. They are vars, not consts,
+// so tests can shrink them.
+var (
+	eventPollInterval = 2 * time.Second
+	eventMaxBackoff   = 30 * time.Second
+)
+
+// EventFilter selects which on-chain analytics events SubscribeEvents
+// delivers. Cursor resumes delivery from the last processed ledger; leave
+// it empty to start from the latest ledger. OnError, if set, is called
+// with each getEvents failure so callers can observe persistent problems
+// (a bad filter, an auth failure, a removed contract) instead of the
+// subscription retrying silently forever.
+type EventFilter struct {
+	ContractID string
+	Topics     []string
+	Cursor     string
+	OnError    func(error)
+}
+
+// AnalyticsEvent is a single on-chain event emitted by the analytics
+// contract (session recorded, module completed, score updated).
+type AnalyticsEvent struct {
+	Type       string          `json:"type"`
+	LedgerSeq  uint64          `json:"ledger"`
+	Cursor     string          `json:"pagingToken"`
+	ContractID string          `json:"contractId"`
+	Data       json.RawMessage `json:"value"`
+}
+
+type getEventsResult struct {
+	Events []AnalyticsEvent `json:"events"`
+	Cursor string           `json:"cursor"`
+}
+
+// SubscribeEvents polls Soroban's getEvents RPC for events matching
+// filter and delivers them on the returned channel. SubscribeEvents only
+// stops, closing the channel, once ctx is done — a getEvents failure does
+// not end the subscription. Instead, each failure is reported to
+// filter.OnError (if set) and pushes the poll interval into exponential
+// backoff, up to eventMaxBackoff; the interval resets to
+// eventPollInterval as soon as a poll succeeds. Each delivered event's
+// cursor, and then the page cursor getEvents returns (when non-empty), is
+// recorded and available via LastCursor for durable checkpointing across
+// restarts.
+func (c *AnalyticsClient) SubscribeEvents(ctx context.Context, filter EventFilter) (<-chan AnalyticsEvent, error) {
+	out := make(chan AnalyticsEvent)
+	cursor := filter.Cursor
+
+	go func() {
+		defer close(out)
+		interval := eventPollInterval
+		timer := time.NewTimer(interval)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+				var result getEventsResult
+				err := c.rpcCallContext(ctx, "getEvents", map[string]interface{}{
+					"contractId": filter.ContractID,
+					"topics":     filter.Topics,
+					"cursor":     cursor,
+				}, &result)
+				if err != nil {
+					if filter.OnError != nil {
+						filter.OnError(err)
+					}
+					interval *= 2
+					if interval > eventMaxBackoff {
+						interval = eventMaxBackoff
+					}
+					timer.Reset(interval)
+					continue
+				}
+				interval = eventPollInterval
+
+				for _, event := range result.Events {
+					select {
+					case out <- event:
+						cursor = event.Cursor
+						c.lastCursor.store(cursor)
+					case <-ctx.Done():
+						return
+					}
+				}
+				if result.Cursor != "" {
+					cursor = result.Cursor
+					c.lastCursor.store(cursor)
+				}
+				timer.Reset(interval)
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// cursorStore is a concurrency-safe holder for the most recently processed
+// event cursor.
+type cursorStore struct {
+	mu    sync.Mutex
+	value string
+}
+
+func (s *cursorStore) store(v string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.value = v
+}
+
+func (s *cursorStore) load() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.value
+}
+
+// LastCursor returns the cursor most recently recorded by SubscribeEvents:
+// either the last delivered event's cursor or the non-empty page cursor
+// from the getEvents response that followed it. It is for durable
+// checkpointing across restarts.
+func (c *AnalyticsClient) LastCursor() string {
+	return c.lastCursor.load()
+}
@@ -0,0 +1,111 @@
+package strellerminds
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func writeJSONRPCResult(w http.ResponseWriter, result interface{}) {
+	body, _ := json.Marshal(result)
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":` + string(body) + `}`))
+}
+
+func withShortEventPolling(t *testing.T) {
+	t.Helper()
+	origInterval, origBackoff := eventPollInterval, eventMaxBackoff
+	eventPollInterval = 5 * time.Millisecond
+	eventMaxBackoff = 20 * time.Millisecond
+	t.Cleanup(func() { eventPollInterval, eventMaxBackoff = origInterval, origBackoff })
+}
+
+func TestSubscribeEventsAdvancesCursorAndDeliversEvents(t *testing.T) {
+	withShortEventPolling(t)
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			writeJSONRPCResult(w, getEventsResult{
+				Events: []AnalyticsEvent{{Type: "session_recorded", Cursor: "cursor-1"}},
+				Cursor: "cursor-1",
+			})
+			return
+		}
+		writeJSONRPCResult(w, getEventsResult{Cursor: "cursor-1"})
+	}))
+	defer server.Close()
+
+	c := NewClient("contract", server.URL, "Test Network")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := c.SubscribeEvents(ctx, EventFilter{ContractID: "contract"})
+	if err != nil {
+		t.Fatalf("SubscribeEvents: %v", err)
+	}
+
+	select {
+	case event := <-events:
+		if event.Cursor != "cursor-1" {
+			t.Errorf("event cursor = %q, want %q", event.Cursor, "cursor-1")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+
+	deadline := time.After(time.Second)
+	for c.LastCursor() != "cursor-1" {
+		select {
+		case <-deadline:
+			t.Fatalf("LastCursor() never advanced, got %q", c.LastCursor())
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+func TestSubscribeEventsSurfacesErrorsAndClosesOnCancel(t *testing.T) {
+	withShortEventPolling(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":1,"message":"bad filter"}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient("contract", server.URL, "Test Network")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var errCount int32
+	events, err := c.SubscribeEvents(ctx, EventFilter{
+		ContractID: "contract",
+		OnError:    func(error) { atomic.AddInt32(&errCount, 1) },
+	})
+	if err != nil {
+		t.Fatalf("SubscribeEvents: %v", err)
+	}
+
+	deadline := time.After(time.Second)
+	for atomic.LoadInt32(&errCount) < 2 {
+		select {
+		case <-deadline:
+			t.Fatalf("OnError was called %d times, want at least 2", atomic.LoadInt32(&errCount))
+		case <-time.After(time.Millisecond):
+		}
+	}
+
+	cancel()
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Error("expected channel to be closed after ctx cancellation, got an event")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for channel to close")
+	}
+}
@@ -0,0 +1,73 @@
+package strellerminds
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type jsonRPCRequest struct {
+	JSONRPC string      `json:"jsonrpc"`
+	ID      int         `json:"id"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params,omitempty"`
+}
+
+type jsonRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type jsonRPCResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *jsonRPCError   `json:"error"`
+}
+
+// rpcCall issues a JSON-RPC 2.0 request to the Soroban RPC endpoint at
+// c.RPCURL and decodes the result into out. It uses context.Background(),
+// so neither the client's read nor its write deadline applies.
+func (c *AnalyticsClient) rpcCall(method string, params interface{}, out interface{}) error {
+	return c.rpcCallContext(context.Background(), method, params, out)
+}
+
+// rpcCallContext is the context-aware variant of rpcCall. Long-running
+// calls (transaction polling, simulation) are cancelled as soon as ctx is
+// done, and the resulting error wraps ctx.Err() so callers can detect a
+// deadline with errors.Is(err, context.DeadlineExceeded).
+func (c *AnalyticsClient) rpcCallContext(ctx context.Context, method string, params interface{}, out interface{}) error {
+	body, err := json.Marshal(jsonRPCRequest{JSONRPC: "2.0", ID: 1, Method: method, Params: params})
+	if err != nil {
+		return newError(ErrCodeNetwork, "failed to marshal RPC request", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.RPCURL, bytes.NewReader(body))
+	if err != nil {
+		return newError(ErrCodeNetwork, "failed to build RPC request", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return newError(ErrCodeTimeout, fmt.Sprintf("RPC call %q cancelled", method), ctx.Err())
+		}
+		return newError(ErrCodeNetwork, fmt.Sprintf("RPC call %q failed", method), err)
+	}
+	defer resp.Body.Close()
+
+	var rpcResp jsonRPCResponse
+	if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+		return newError(ErrCodeNetwork, "failed to decode RPC response", err)
+	}
+	if rpcResp.Error != nil {
+		return newError(ErrCodeContract, rpcResp.Error.Message, fmt.Errorf("rpc error code %d", rpcResp.Error.Code))
+	}
+	if out != nil {
+		if err := json.Unmarshal(rpcResp.Result, out); err != nil {
+			return newError(ErrCodeNetwork, "failed to decode RPC result", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package strellerminds
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Signer signs transaction envelopes on behalf of an AnalyticsClient,
+// keeping the private key out of AnalyticsClient itself so callers can
+// back it with an HSM or remote signing service instead of process
+// memory.
+type Signer interface {
+	// SignTransaction signs txXDR under networkPassphrase following
+	// Stellar's real transaction-signing semantics — the signed payload is
+	// sha256(networkID || ENVELOPE_TYPE_TX || txXDR), not txXDR itself —
+	// and returns a decorated signature (4-byte public-key hint followed
+	// by the raw ed25519 signature). See signatureBase/decoratedSignature
+	// in transaction.go.
+	SignTransaction(ctx context.Context, networkPassphrase string, txXDR []byte) ([]byte, error)
+	PublicKey() string
+}
+
+// ClientOption configures optional AnalyticsClient behavior.
+type ClientOption func(*AnalyticsClient)
+
+// WithSigner overrides how transactions are signed. When unset,
+// RecordSession derives a seed-based Signer from its sourceSecret
+// parameter on each call.
+func WithSigner(signer Signer) ClientOption {
+	return func(c *AnalyticsClient) { c.signer = signer }
+}
+
+// seedSigner signs transactions in-process with a Stellar ed25519 seed.
+type seedSigner struct {
+	priv   ed25519.PrivateKey
+	pubKey string
+}
+
+// NewSeedSigner creates a Signer that signs transactions in-process with a
+// Stellar ed25519 seed ("S..."). This is what RecordSession uses under the
+// hood when no Signer has been configured via WithSigner.
+func NewSeedSigner(seed string) (Signer, error) {
+	priv, pub, err := decodeStellarSeed(seed)
+	if err != nil {
+		return nil, newError(ErrCodeSigning, "invalid source secret", err)
+	}
+	return &seedSigner{priv: priv, pubKey: pub}, nil
+}
+
+func (s *seedSigner) SignTransaction(ctx context.Context, networkPassphrase string, txXDR []byte) ([]byte, error) {
+	pub := s.priv.Public().(ed25519.PublicKey)
+	return decoratedSignature(s.priv, pub, networkPassphrase, txXDR), nil
+}
+
+func (s *seedSigner) PublicKey() string { return s.pubKey }
+
+// RemoteSigner delegates signing to an external HTTP signing service,
+// keeping private keys off the machine running AnalyticsClient entirely.
+type RemoteSigner struct {
+	URL        string
+	PubKey     string
+	HTTPClient *http.Client
+}
+
+// NewRemoteSigner creates a RemoteSigner that POSTs signing requests to
+// url for the account identified by publicKey.
+func NewRemoteSigner(url, publicKey string) *RemoteSigner {
+	return &RemoteSigner{URL: url, PubKey: publicKey}
+}
+
+// PublicKey implements Signer.
+func (s *RemoteSigner) PublicKey() string { return s.PubKey }
+
+// SignTransaction implements Signer. It computes the same signatureBase a
+// seedSigner would, since that's a public algorithm requiring no key
+// material, and asks the remote signing service to sign only that hash —
+// so the service never sees more of the transaction than it needs to.
+func (s *RemoteSigner) SignTransaction(ctx context.Context, networkPassphrase string, txXDR []byte) ([]byte, error) {
+	hash := signatureBase(networkPassphrase, txXDR)
+
+	reqBody, err := json.Marshal(map[string]string{
+		"publicKey": s.PubKey,
+		"hash":      base64.StdEncoding.EncodeToString(hash[:]),
+	})
+	if err != nil {
+		return nil, newError(ErrCodeSigning, "failed to marshal remote signing request", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, newError(ErrCodeSigning, "failed to build remote signing request", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := s.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, newError(ErrCodeSigning, "remote signer request failed", err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Signature string `json:"signature"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, newError(ErrCodeSigning, "failed to decode remote signer response", err)
+	}
+	sig, err := base64.StdEncoding.DecodeString(result.Signature)
+	if err != nil {
+		return nil, newError(ErrCodeSigning, "remote signer returned an invalid signature", err)
+	}
+
+	pubKeyBytes, err := decodeStrkey(s.PubKey, strkeyVersionPublicKey)
+	if err != nil {
+		return nil, newError(ErrCodeSigning, "remote signer has an invalid public key", err)
+	}
+	hint := pubKeyBytes[len(pubKeyBytes)-4:]
+	decorated := make([]byte, 0, len(hint)+len(sig))
+	decorated = append(decorated, hint...)
+	decorated = append(decorated, sig...)
+	return decorated, nil
+}
+
+// FileKeystoreSigner loads a Stellar seed from a file on disk the first
+// time it is used, then signs in-process like NewSeedSigner. This keeps
+// the seed out of application config/environment variables at the cost of
+// still holding it in process memory once loaded.
+type FileKeystoreSigner struct {
+	Path string
+
+	once    sync.Once
+	inner   Signer
+	loadErr error
+}
+
+// NewFileKeystoreSigner creates a FileKeystoreSigner backed by the seed
+// stored at path.
+func NewFileKeystoreSigner(path string) *FileKeystoreSigner {
+	return &FileKeystoreSigner{Path: path}
+}
+
+func (s *FileKeystoreSigner) load() {
+	s.once.Do(func() {
+		data, err := os.ReadFile(s.Path)
+		if err != nil {
+			s.loadErr = newError(ErrCodeSigning, "failed to read keystore file", err)
+			return
+		}
+		s.inner, s.loadErr = NewSeedSigner(strings.TrimSpace(string(data)))
+	})
+}
+
+// PublicKey implements Signer.
+func (s *FileKeystoreSigner) PublicKey() string {
+	s.load()
+	if s.inner == nil {
+		return ""
+	}
+	return s.inner.PublicKey()
+}
+
+// SignTransaction implements Signer.
+func (s *FileKeystoreSigner) SignTransaction(ctx context.Context, networkPassphrase string, txXDR []byte) ([]byte, error) {
+	s.load()
+	if s.loadErr != nil {
+		return nil, s.loadErr
+	}
+	return s.inner.SignTransaction(ctx, networkPassphrase, txXDR)
+}
@@ -0,0 +1,78 @@
+package strellerminds
+
+import (
+	"crypto/ed25519"
+	"encoding/base32"
+	"fmt"
+)
+
+// Stellar strkey version bytes (see SEP-0023): the low 3 bits of the
+// version byte are always zero, with the high bits identifying the key
+// type ("S..." for seeds, "G..." for ed25519 public keys).
+const (
+	strkeyVersionSeed      byte = 18 << 3
+	strkeyVersionPublicKey byte = 6 << 3
+)
+
+var strkeyEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// crc16xmodem computes the XMODEM CRC16 used by Stellar's strkey checksum.
+func crc16xmodem(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// decodeStrkey decodes a strkey-encoded value and verifies its version
+// byte and checksum, returning the payload.
+func decodeStrkey(s string, expectedVersion byte) ([]byte, error) {
+	data, err := strkeyEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base32 encoding: %w", err)
+	}
+	if len(data) < 3 {
+		return nil, fmt.Errorf("key too short")
+	}
+	payload := data[1 : len(data)-2]
+	checksum := data[len(data)-2:]
+	if data[0] != expectedVersion {
+		return nil, fmt.Errorf("unexpected strkey version %d", data[0])
+	}
+	want := crc16xmodem(data[:len(data)-2])
+	if checksum[0] != byte(want) || checksum[1] != byte(want>>8) {
+		return nil, fmt.Errorf("checksum mismatch")
+	}
+	return payload, nil
+}
+
+// encodeStrkey strkey-encodes payload under the given version byte.
+func encodeStrkey(version byte, payload []byte) string {
+	data := append([]byte{version}, payload...)
+	crc := crc16xmodem(data)
+	data = append(data, byte(crc), byte(crc>>8))
+	return strkeyEncoding.EncodeToString(data)
+}
+
+// decodeStellarSeed decodes a Stellar "S..." ed25519 seed into a private
+// key and its corresponding "G..." public key.
+func decodeStellarSeed(seed string) (ed25519.PrivateKey, string, error) {
+	rawSeed, err := decodeStrkey(seed, strkeyVersionSeed)
+	if err != nil {
+		return nil, "", fmt.Errorf("decode seed: %w", err)
+	}
+	if len(rawSeed) != ed25519.SeedSize {
+		return nil, "", fmt.Errorf("seed must be %d bytes, got %d", ed25519.SeedSize, len(rawSeed))
+	}
+	priv := ed25519.NewKeyFromSeed(rawSeed)
+	pub := priv.Public().(ed25519.PublicKey)
+	return priv, encodeStrkey(strkeyVersionPublicKey, pub), nil
+}
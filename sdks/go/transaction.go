@@ -0,0 +1,170 @@
+package strellerminds
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// envelopeTypeTx is XDR's EnvelopeType.ENVELOPE_TYPE_TX, the tag Stellar
+// mixes into the hash of every transaction signature.
+const envelopeTypeTx uint32 = 2
+
+// pollInterval and maxPollAttempts govern how long RecordSession waits for
+// a submitted transaction to leave the Soroban mempool.
+const (
+	pollInterval    = 1 * time.Second
+	maxPollAttempts = 30
+)
+
+type sendTransactionResult struct {
+	Hash   string `json:"hash"`
+	Status string `json:"status"`
+}
+
+type getTransactionResult struct {
+	Status       string `json:"status"`
+	ResultXdr    string `json:"resultXdr"`
+	ErrorMessage string `json:"errorResultXdr,omitempty"`
+}
+
+type getLedgerEntriesResult struct {
+	Entries []struct {
+		Sequence string `json:"sequence"`
+	} `json:"entries"`
+}
+
+// fetchAccountSequenceContext looks up pub's current sequence number via
+// getLedgerEntries keyed on its Account ledger entry. Soroban RPC has no
+// getAccount method — the real method set is getEvents, getFeeStats,
+// getHealth, getLatestLedger, getLedgerEntries, getNetwork,
+// getTransaction(s), sendTransaction, simulateTransaction, and
+// getVersionInfo — so account lookups go through getLedgerEntries (or
+// Horizon's REST API) instead. The key below is a stub: a real Account
+// LedgerKey needs the same full XDR codec called out in the scValType doc
+// comment in xdr.go, which this module does not currently depend on.
+func (c *AnalyticsClient) fetchAccountSequenceContext(ctx context.Context, pub string) (int64, error) {
+	var result getLedgerEntriesResult
+	if err := c.rpcCallContext(ctx, "getLedgerEntries", map[string]interface{}{
+		"keys": []string{base64XDR(scString(pub).encode())},
+	}, &result); err != nil {
+		return 0, err
+	}
+	if len(result.Entries) == 0 {
+		return 0, newError(ErrCodeNetwork, "account not found", nil)
+	}
+	var sequence int64
+	if _, err := fmt.Sscanf(result.Entries[0].Sequence, "%d", &sequence); err != nil {
+		return 0, newError(ErrCodeNetwork, "invalid account sequence", err)
+	}
+	return sequence, nil
+}
+
+// buildInvokeHostFunctionEnvelope assembles an unsigned, stub
+// InvokeHostFunction transaction envelope by concatenating the network
+// passphrase, contract ID, source public key, function name, big-endian
+// sequence number, and encoded args. It carries no fee, time bounds, or
+// auth as a real TransactionEnvelope XDR structure does. It is NOT a real transaction and a real Soroban RPC
+// node will not accept it — see the scValType doc comment in xdr.go for
+// why, and what a real implementation needs.
+func (c *AnalyticsClient) buildInvokeHostFunctionEnvelope(sourcePublicKey, function string, args [][]byte, sequence int64) []byte {
+	var buf []byte
+	buf = append(buf, []byte(c.NetworkPassphrase)...)
+	buf = append(buf, []byte(c.ContractID)...)
+	buf = append(buf, []byte(sourcePublicKey)...)
+	buf = append(buf, []byte(function)...)
+	seqBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(seqBytes, uint64(sequence))
+	buf = append(buf, seqBytes...)
+	for _, a := range args {
+		buf = append(buf, a...)
+	}
+	return buf
+}
+
+// signatureBase computes sha256(networkID || ENVELOPE_TYPE_TX || envelope),
+// the hash Stellar/Soroban transactions are actually signed over (per the
+// TransactionSignaturePayload XDR structure), where networkID is itself
+// sha256(networkPassphrase). Mixing in the network ID means a signature
+// produced for testnet cannot be replayed against mainnet.
+func signatureBase(networkPassphrase string, envelope []byte) [32]byte {
+	networkID := sha256.Sum256([]byte(networkPassphrase))
+	var tag [4]byte
+	binary.BigEndian.PutUint32(tag[:], envelopeTypeTx)
+
+	payload := make([]byte, 0, len(networkID)+len(tag)+len(envelope))
+	payload = append(payload, networkID[:]...)
+	payload = append(payload, tag[:]...)
+	payload = append(payload, envelope...)
+	return sha256.Sum256(payload)
+}
+
+// decoratedSignature signs envelope's signatureBase with priv and prefixes
+// the result with a 4-byte signature hint (the last 4 bytes of pub), the
+// way a real XDR DecoratedSignature is shaped, so a caller that does
+// implement real transaction XDR can attach it without further
+// bookkeeping.
+func decoratedSignature(priv ed25519.PrivateKey, pub ed25519.PublicKey, networkPassphrase string, envelope []byte) []byte {
+	hash := signatureBase(networkPassphrase, envelope)
+	sig := ed25519.Sign(priv, hash[:])
+
+	hint := pub[len(pub)-4:]
+	decorated := make([]byte, 0, len(hint)+len(sig))
+	decorated = append(decorated, hint...)
+	decorated = append(decorated, sig...)
+	return decorated
+}
+
+// simulateTransaction runs the envelope through simulateTransaction to
+// surface contract-side failures (bad arguments, auth errors) before it is
+// signed and submitted.
+func (c *AnalyticsClient) simulateTransaction(envelope []byte) (*getTransactionResult, error) {
+	return c.simulateTransactionContext(context.Background(), envelope)
+}
+
+// simulateTransactionContext is the context-aware variant of
+// simulateTransaction.
+func (c *AnalyticsClient) simulateTransactionContext(ctx context.Context, envelope []byte) (*getTransactionResult, error) {
+	var result getTransactionResult
+	if err := c.rpcCallContext(ctx, "simulateTransaction", map[string]string{"transaction": base64XDR(envelope)}, &result); err != nil {
+		return nil, newError(ErrCodeSimulation, "transaction simulation failed", err)
+	}
+	return &result, nil
+}
+
+// pollTransaction polls getTransaction until the submitted transaction
+// leaves the pending state, backing off between attempts.
+func (c *AnalyticsClient) pollTransaction(hash string) (string, error) {
+	return c.pollTransactionContext(context.Background(), hash)
+}
+
+// pollTransactionContext is the context-aware variant of pollTransaction.
+// If ctx is done before the transaction confirms, it returns an error
+// wrapping ctx.Err() (ordinarily context.DeadlineExceeded).
+func (c *AnalyticsClient) pollTransactionContext(ctx context.Context, hash string) (string, error) {
+	backoff := pollInterval
+	for attempt := 0; attempt < maxPollAttempts; attempt++ {
+		var result getTransactionResult
+		if err := c.rpcCallContext(ctx, "getTransaction", map[string]string{"hash": hash}, &result); err != nil {
+			return "", err
+		}
+		switch result.Status {
+		case "SUCCESS":
+			return hash, nil
+		case "FAILED":
+			return "", newError(ErrCodeTransactionFailed, "transaction failed", fmt.Errorf("%s", result.ErrorMessage))
+		}
+		select {
+		case <-ctx.Done():
+			return "", newError(ErrCodeTimeout, "transaction did not confirm before deadline", ctx.Err())
+		case <-time.After(backoff):
+		}
+		if backoff < 10*time.Second {
+			backoff *= 2
+		}
+	}
+	return "", newError(ErrCodeTimeout, "transaction did not confirm in time", nil)
+}
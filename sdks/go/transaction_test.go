@@ -0,0 +1,38 @@
+package strellerminds
+
+import (
+	"crypto/ed25519"
+	"testing"
+)
+
+func TestDecoratedSignatureVerifies(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	envelope := []byte("stub envelope bytes")
+
+	decorated := decoratedSignature(priv, pub, "Test Network ; July 2026", envelope)
+	if len(decorated) != 4+ed25519.SignatureSize {
+		t.Fatalf("decoratedSignature length = %d, want %d", len(decorated), 4+ed25519.SignatureSize)
+	}
+
+	hint, sig := decorated[:4], decorated[4:]
+	if string(hint) != string(pub[len(pub)-4:]) {
+		t.Errorf("hint = %x, want last 4 bytes of public key %x", hint, pub[len(pub)-4:])
+	}
+
+	hash := signatureBase("Test Network ; July 2026", envelope)
+	if !ed25519.Verify(pub, hash[:], sig) {
+		t.Error("decorated signature does not verify against signatureBase(passphrase, envelope)")
+	}
+}
+
+func TestSignatureBaseVariesByNetworkPassphrase(t *testing.T) {
+	envelope := []byte("stub envelope bytes")
+	a := signatureBase("Public Global Stellar Network ; September 2015", envelope)
+	b := signatureBase("Test SDF Network ; September 2015", envelope)
+	if a == b {
+		t.Error("signatureBase should differ across network passphrases, so a testnet signature cannot replay on mainnet")
+	}
+}
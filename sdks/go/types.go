@@ -11,6 +11,17 @@ type LearningSession struct {
 // ProgressAnalytics represents student progress data
 type ProgressAnalytics struct {
 	CompletedModules uint32 `json:"completed_modules"`
-	TotalTime        uint64 `json:"total_time"`
-	Score            uint32 `json:"score"`
+	// TotalTime is reserved for a student's cumulative learning time.
+	// LearningSession does not currently record a session duration or end
+	// time, so ProgressAggregator.Compute cannot fill this in yet — see the
+	// TODO there. It is always zero until that field exists.
+	TotalTime uint64 `json:"total_time"`
+	Score     uint32 `json:"score"`
+}
+
+// BatchResult is the per-session outcome of RecordSessions.
+type BatchResult struct {
+	Session LearningSession `json:"session"`
+	TxHash  string          `json:"tx_hash,omitempty"`
+	Err     error           `json:"-"`
 }
@@ -0,0 +1,74 @@
+package strellerminds
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+)
+
+// scValType holds the real SCValType XDR enum ordinals for the handful of
+// ScVal variants encodeSessionArgs needs.
+//
+// NOTE: scVal.encode below is NOT a conformant XDR encoder — it borrows
+// the right discriminant values but skips the union/struct framing a real
+// ScVal (and the surrounding LedgerKey/TransactionEnvelope it lives in)
+// requires. A real Soroban RPC node will reject a transaction built from
+// it. Producing a request that an RPC node actually accepts requires a
+// full XDR codec such as github.com/stellar/go/xdr, which this module
+// does not currently depend on. Until that dependency is added, treat
+// everything built from encodeSessionArgs as a stub wire format useful
+// for exercising this package's RPC/signing/concurrency plumbing only.
+type scValType uint32
+
+const (
+	scValTypeU64    scValType = 5  // SCV_U64
+	scValTypeString scValType = 14 // SCV_STRING
+	scValTypeSymbol scValType = 15 // SCV_SYMBOL
+)
+
+// scVal is a write-only, simplified encoding of a Soroban ScVal: a 4-byte
+// big-endian discriminant followed by its payload, padded to a 4-byte
+// boundary the way XDR requires. See the scValType doc comment for why
+// this is not a real XDR encoder.
+type scVal struct {
+	kind scValType
+	str  string
+	u64  uint64
+}
+
+func scString(s string) scVal { return scVal{kind: scValTypeString, str: s} }
+func scSymbol(s string) scVal { return scVal{kind: scValTypeSymbol, str: s} }
+func scU64(v uint64) scVal    { return scVal{kind: scValTypeU64, u64: v} }
+
+func (v scVal) encode() []byte {
+	var buf []byte
+	buf = binary.BigEndian.AppendUint32(buf, uint32(v.kind))
+	if v.kind == scValTypeU64 {
+		return binary.BigEndian.AppendUint64(buf, v.u64)
+	}
+	b := []byte(v.str)
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(b)))
+	buf = append(buf, b...)
+	if pad := (4 - len(b)%4) % 4; pad > 0 {
+		buf = append(buf, make([]byte, pad)...)
+	}
+	return buf
+}
+
+// encodeSessionArgs stub-encodes a LearningSession's fields, in the order
+// the record_session contract function expects them, using the
+// simplified scVal.encode above rather than real XDR.
+func encodeSessionArgs(session LearningSession) [][]byte {
+	args := []scVal{
+		scString(session.ID),
+		scString(session.Student),
+		scU64(session.StartTime),
+		scString(session.CourseID),
+	}
+	encoded := make([][]byte, len(args))
+	for i, a := range args {
+		encoded[i] = a.encode()
+	}
+	return encoded
+}
+
+func base64XDR(b []byte) string { return base64.StdEncoding.EncodeToString(b) }